@@ -51,6 +51,12 @@ var (
 	TaskTypeCompare = models.MustNewTaskType("compare")
 	// TaskTypeQuotient is the identifier for the Quotient adapter.
 	TaskTypeQuotient = models.MustNewTaskType("quotient")
+	// TaskTypeCosmosBech32 is the identifier for the CosmosBech32 adapter.
+	TaskTypeCosmosBech32 = models.MustNewTaskType("cosmosbech32")
+	// TaskTypeCosmosDec is the identifier for the CosmosDec adapter.
+	TaskTypeCosmosDec = models.MustNewTaskType("cosmosdec")
+	// TaskTypeCosmosCoin is the identifier for the CosmosCoin adapter.
+	TaskTypeCosmosCoin = models.MustNewTaskType("cosmoscoin")
 )
 
 // BaseAdapter is the minimum interface required to create an adapter. Only core
@@ -153,6 +159,12 @@ func FindNativeAdapterFor(task models.TaskSpec) BaseAdapter {
 		return &Compare{}
 	case TaskTypeQuotient:
 		return &Quotient{}
+	case TaskTypeCosmosBech32:
+		return &CosmosBech32{}
+	case TaskTypeCosmosDec:
+		return &CosmosDec{}
+	case TaskTypeCosmosCoin:
+		return &CosmosCoin{}
 	default:
 		return nil
 	}
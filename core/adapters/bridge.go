@@ -9,6 +9,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
 
 	"github.com/pkg/errors"
 	"github.com/tidwall/gjson"
@@ -39,8 +40,24 @@ func (ba *Bridge) Perform(input models.RunInput, store *store.Store) models.RunO
 	} else if input.Status().PendingBridge() {
 		return models.NewRunOutputInProgress(input.Data())
 	}
+	bridgeURL, err := ba.environmentURL(store, input.JobRunID())
+	if err != nil {
+		return models.NewRunOutputError(baRunResultError("resolving environment URL", err))
+	}
 	meta := getMeta(store, input.JobRunID())
-	return ba.handleNewRun(input, meta, store)
+	return ba.handleNewRun(input, meta, bridgeURL, store)
+}
+
+// environmentURL returns the URL to post to for the environment of the job
+// the run belongs to. Runs that aren't persisted use the bridge's default URL.
+func (ba *Bridge) environmentURL(store *store.Store, jobRunID *models.ID) (models.WebURL, error) {
+	environment, err := store.ORM.FindJobRunEnvironment(jobRunID)
+	if errors.Cause(err) == orm.ErrorNotFound {
+		return ba.URL, nil
+	} else if err != nil {
+		return models.WebURL{}, err
+	}
+	return ba.URLFor(environment)
 }
 
 func getMeta(store *store.Store, jobRunID *models.ID) *models.JSON {
@@ -63,7 +80,7 @@ func getMeta(store *store.Store, jobRunID *models.ID) *models.JSON {
 	return &models.JSON{Result: gjson.Parse(meta)}
 }
 
-func (ba *Bridge) handleNewRun(input models.RunInput, meta *models.JSON, store *store.Store) models.RunOutput {
+func (ba *Bridge) handleNewRun(input models.RunInput, meta *models.JSON, bridgeURL models.WebURL, store *store.Store) models.RunOutput {
 	data, err := models.Merge(input.Data(), ba.Params)
 	if err != nil {
 		return models.NewRunOutputError(baRunResultError("handling data param", err))
@@ -76,7 +93,7 @@ func (ba *Bridge) handleNewRun(input models.RunInput, meta *models.JSON, store *
 
 	httpConfig := defaultHTTPConfig(store)
 
-	body, err := ba.postToExternalAdapter(input, meta, responseURL, httpConfig)
+	body, err := ba.postToExternalAdapter(input, meta, bridgeURL, responseURL, httpConfig)
 	if err != nil {
 		return models.NewRunOutputError(baRunResultError("post to external adapter", err))
 	}
@@ -115,6 +132,7 @@ func (ba *Bridge) responseToRunResult(body []byte, input models.RunInput) models
 func (ba *Bridge) postToExternalAdapter(
 	input models.RunInput,
 	meta *models.JSON,
+	bridgeURL models.WebURL,
 	bridgeResponseURL *url.URL,
 	config HTTPRequestConfig,
 ) ([]byte, error) {
@@ -132,7 +150,7 @@ func (ba *Bridge) postToExternalAdapter(
 		return nil, fmt.Errorf("marshaling request body: %v", err)
 	}
 
-	request, err := http.NewRequest("POST", ba.URL.String(), bytes.NewBuffer(in))
+	request, err := http.NewRequest("POST", bridgeURL.String(), bytes.NewBuffer(in))
 	if err != nil {
 		return nil, fmt.Errorf("building outgoing bridge http post: %v", err)
 	}
@@ -87,6 +87,31 @@ func TestBridge_IncludesMetaIfJobRunIsInDB(t *testing.T) {
 	assert.Equal(t, "Bearer "+bt.OutgoingToken, token)
 }
 
+func TestBridge_PerformUsesJobEnvironmentURL(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	store.Config.Set("BRIDGE_RESPONSE_URL", cltest.WebURL(t, ""))
+
+	mock, ensureCalled := cltest.NewHTTPMockServer(t, http.StatusOK, "POST", `{"pending": true}`)
+	defer ensureCalled()
+
+	_, bt := cltest.NewBridgeType(t, "auctionBidding", "https://prod.example.com/api")
+	bt.Environments = models.BridgeEnvironments{"staging": cltest.WebURL(t, mock.URL)}
+	require.NoError(t, store.CreateBridgeType(bt))
+
+	job := cltest.NewJobWithWebInitiator()
+	job.Environment = "staging"
+	require.NoError(t, store.CreateJob(&job))
+	jr := cltest.NewJobRun(job)
+	require.NoError(t, store.CreateJobRun(&jr))
+
+	ba := &adapters.Bridge{BridgeType: *bt}
+	input := *models.NewRunInput(jr.ID, *models.NewID(), cltest.JSONFromString(t, `{"result":"100"}`), models.RunStatusUnstarted)
+	result := ba.Perform(input, store)
+	require.NoError(t, result.Error())
+	assert.True(t, result.Status().PendingBridge())
+}
+
 func TestBridge_PerformAcceptsNonJsonObjectResponses(t *testing.T) {
 	store, cleanup := cltest.NewStore(t)
 	defer cleanup()
@@ -0,0 +1,146 @@
+package adapters
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// cosmosDecPrecision is the number of decimal places of a Cosmos SDK sdk.Dec.
+	cosmosDecPrecision = 18
+	// cosmosAddressLength and cosmosModuleAddressLength are the byte lengths of
+	// Cosmos SDK account and module addresses.
+	cosmosAddressLength       = 20
+	cosmosModuleAddressLength = 32
+)
+
+var (
+	cosmosDenomRegexp  = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9/:._-]{2,127}$`)
+	cosmosAmountRegexp = regexp.MustCompile(`^[0-9]+$`)
+)
+
+// CosmosBech32 holds the human readable prefix to encode addresses with.
+type CosmosBech32 struct {
+	Prefix string `json:"prefix"`
+}
+
+// TaskType returns the type of Adapter.
+func (c *CosmosBech32) TaskType() models.TaskType {
+	return TaskTypeCosmosBech32
+}
+
+// Perform validates that the input is a 20 or 32 byte bech32 address and
+// returns it in lowercase, re-encoded with the Prefix when one is given. Hex
+// input is treated as raw address bytes and requires a Prefix.
+//
+// For example, with a prefix of "cosmos" the address
+// "iaa1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnk53tng" becomes
+// "cosmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnrk363e".
+func (c *CosmosBech32) Perform(input models.RunInput, _ *store.Store) models.RunOutput {
+	value := strings.TrimSpace(input.Result().String())
+
+	var hrp string
+	var data []byte
+	var err error
+	if utils.HasHexPrefix(value) {
+		data, err = hexutil.Decode(value)
+	} else {
+		hrp, data, err = utils.Bech32Decode(value)
+	}
+	if err != nil {
+		return models.NewRunOutputError(errors.Wrapf(err, "parsing %v as an address", value))
+	}
+	if len(data) != cosmosAddressLength && len(data) != cosmosModuleAddressLength {
+		return models.NewRunOutputError(fmt.Errorf("address %v is %d bytes, expected %d or %d", value, len(data), cosmosAddressLength, cosmosModuleAddressLength))
+	}
+
+	if c.Prefix != "" {
+		hrp = c.Prefix
+	}
+	if hrp == "" {
+		return models.NewRunOutputError(errors.New("a prefix is required to encode hex addresses"))
+	}
+
+	address, err := utils.Bech32Encode(hrp, data)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	return models.NewRunOutputCompleteWithResult(address)
+}
+
+// CosmosDec holds no fields.
+type CosmosDec struct{}
+
+// TaskType returns the type of Adapter.
+func (c *CosmosDec) TaskType() models.TaskType {
+	return TaskTypeCosmosDec
+}
+
+// Perform returns the input formatted as a Cosmos SDK sdk.Dec string, with
+// exactly 18 decimal places. Further decimal places are rounded half to even.
+//
+// For example, the input "1.5" becomes "1.500000000000000000".
+func (*CosmosDec) Perform(input models.RunInput, _ *store.Store) models.RunOutput {
+	value := input.Result().String()
+	dec, err := decimal.NewFromString(value)
+	if err != nil {
+		return models.NewRunOutputError(errors.Wrapf(err, "parsing %v as a decimal", value))
+	}
+	return models.NewRunOutputCompleteWithResult(dec.StringFixedBank(cosmosDecPrecision))
+}
+
+// CosmosCoin holds the denomination of the resulting coin and the number of
+// decimal places between the input amount and that denomination.
+type CosmosCoin struct {
+	Denom    string `json:"denom"`
+	Exponent int32  `json:"exponent"`
+}
+
+// TaskType returns the type of Adapter.
+func (c *CosmosCoin) TaskType() models.TaskType {
+	return TaskTypeCosmosCoin
+}
+
+// Perform returns the input amount as a Cosmos SDK coin string in the Denom,
+// after shifting it by Exponent decimal places. The resulting amount must be
+// a non-negative integer. Input that already is a coin string in the Denom,
+// i.e. digits followed by the Denom, is returned as is.
+//
+// For example, with a denom of "uirita" and an exponent of 6, the input "1.5"
+// becomes "1500000uirita".
+func (c *CosmosCoin) Perform(input models.RunInput, _ *store.Store) models.RunOutput {
+	if !cosmosDenomRegexp.MatchString(c.Denom) {
+		return models.NewRunOutputError(fmt.Errorf("invalid denom %q", c.Denom))
+	}
+
+	value := strings.TrimSpace(input.Result().String())
+	if base := strings.TrimSuffix(value, c.Denom); base != value && cosmosAmountRegexp.MatchString(base) {
+		amount, err := decimal.NewFromString(base)
+		if err != nil {
+			return models.NewRunOutputError(err)
+		}
+		return models.NewRunOutputCompleteWithResult(amount.String() + c.Denom)
+	}
+
+	amount, err := decimal.NewFromString(value)
+	if err != nil {
+		return models.NewRunOutputError(errors.Wrapf(err, "parsing %v as a coin amount", value))
+	}
+	amount = amount.Shift(c.Exponent)
+	if amount.IsNegative() {
+		return models.NewRunOutputError(fmt.Errorf("coin amount %v must not be negative", value))
+	}
+	if !amount.Equal(amount.Truncate(0)) {
+		return models.NewRunOutputError(fmt.Errorf("coin amount %v is not a whole number of %v", value, c.Denom))
+	}
+	return models.NewRunOutputCompleteWithResult(amount.Truncate(0).String() + c.Denom)
+}
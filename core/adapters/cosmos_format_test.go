@@ -0,0 +1,143 @@
+package adapters_test
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/adapters"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCosmosBech32_Perform(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		prefix  string
+		json    string
+		want    string
+		errored bool
+	}{
+		{"valid address", "", `{"result":"iaa1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnk53tng"}`,
+			"iaa1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnk53tng", false},
+		{"uppercase address", "", `{"result":"IAA1QQQSYQCYQ5RQWZQFPG9SCRGWPUGPZYSNK53TNG"}`,
+			"iaa1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnk53tng", false},
+		{"address with other prefix", "cosmos", `{"result":"iaa1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnk53tng"}`,
+			"cosmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnrk363e", false},
+		{"hex address", "iaa", `{"result":"0x000102030405060708090a0b0c0d0e0f10111213"}`,
+			"iaa1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnk53tng", false},
+		{"hex address without prefix", "", `{"result":"0x000102030405060708090a0b0c0d0e0f10111213"}`, "", true},
+		{"bad checksum", "", `{"result":"iaa1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnk53tnq"}`, "", true},
+		{"32 byte address", "iaa", `{"result":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"}`,
+			"iaa1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnzs23v9ccrydpk8qarc0s5pq0xk", false},
+		{"empty bech32 address", "", `{"result":"a12uel5l"}`, "", true},
+		{"empty hex address", "iaa", `{"result":"0x"}`, "", true},
+		{"short hex address", "iaa", `{"result":"0x0001020304"}`, "", true},
+		{"number", "", `{"result":123}`, "", true},
+		{"object", "", `{"result":{"a": "b"}}`, "", true},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			input := cltest.NewRunInputWithString(t, test.json)
+			adapter := adapters.CosmosBech32{Prefix: test.prefix}
+			result := adapter.Perform(input, nil)
+
+			if test.errored {
+				assert.Error(t, result.Error())
+			} else {
+				require.NoError(t, result.Error())
+				assert.Equal(t, test.want, result.Result().String())
+			}
+		})
+	}
+}
+
+func TestCosmosDec_Perform(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		json    string
+		want    string
+		errored bool
+	}{
+		{"string", `{"result":"1.5"}`, "1.500000000000000000", false},
+		{"integer", `{"result":123}`, "123.000000000000000000", false},
+		{"float", `{"result":1.23}`, "1.230000000000000000", false},
+		{"negative string", `{"result":"-0.25"}`, "-0.250000000000000000", false},
+		{"scientific string", `{"result":"1.68e+4"}`, "16800.000000000000000000", false},
+		{"rounds half to even down", `{"result":"0.0000000000000000005"}`, "0.000000000000000000", false},
+		{"rounds half to even up", `{"result":"0.0000000000000000015"}`, "0.000000000000000002", false},
+		{"boolean", `{"result":true}`, "", true},
+		{"object", `{"result":{"a": "b"}}`, "", true},
+	}
+
+	adapter := adapters.CosmosDec{}
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			input := cltest.NewRunInputWithString(t, test.json)
+			result := adapter.Perform(input, nil)
+
+			if test.errored {
+				assert.Error(t, result.Error())
+			} else {
+				require.NoError(t, result.Error())
+				assert.Equal(t, test.want, result.Result().String())
+			}
+		})
+	}
+}
+
+func TestCosmosCoin_Perform(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		denom    string
+		exponent int32
+		json     string
+		want     string
+		errored  bool
+	}{
+		{"display amount", "uirita", 6, `{"result":"1.5"}`, "1500000uirita", false},
+		{"float amount", "uirita", 6, `{"result":1.5}`, "1500000uirita", false},
+		{"base amount", "uirita", 0, `{"result":42}`, "42uirita", false},
+		{"scientific amount", "uirita", 6, `{"result":"1.68e+4"}`, "16800000000uirita", false},
+		{"coin in denom", "uirita", 6, `{"result":"1500000uirita"}`, "1500000uirita", false},
+		{"coin in other denom", "uirita", 6, `{"result":"1500000uatom"}`, "", true},
+		{"scientific without denom", "uirita", 0, `{"result":"1e18"}`, "1000000000000000000uirita", false},
+		{"scientific display amount", "uirita", 6, `{"result":"25e10"}`, "250000000000000000uirita", false},
+		{"fractional base amount", "uirita", 6, `{"result":"1.0000005"}`, "", true},
+		{"negative amount", "uirita", 6, `{"result":"-1"}`, "", true},
+		{"missing denom", "", 6, `{"result":"1.5"}`, "", true},
+		{"invalid denom", "1rita", 6, `{"result":"1.5"}`, "", true},
+		{"object", "uirita", 6, `{"result":{"a": "b"}}`, "", true},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			input := cltest.NewRunInputWithString(t, test.json)
+			adapter := adapters.CosmosCoin{Denom: test.denom, Exponent: test.exponent}
+			result := adapter.Perform(input, nil)
+
+			if test.errored {
+				assert.Error(t, result.Error())
+			} else {
+				require.NoError(t, result.Error())
+				assert.Equal(t, test.want, result.Result().String())
+			}
+		})
+	}
+}
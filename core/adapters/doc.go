@@ -8,6 +8,9 @@
 // For example:
 //  {"id": "b8004e2989e24e1d8e4449afad2eb480", "data": {}}
 //
+// A bridge may also define per-environment URLs. When the job spec sets an
+// "environment", the adapter posts to that environment's URL instead.
+//
 // Compare
 //
 // The Compare adapter is used to compare the previous task's result
@@ -98,6 +101,24 @@
 // value.
 //   { "type": "Quotient", "params": {"dividend": 1 }}
 //
+// CosmosBech32
+//
+// The CosmosBech32 adapter validates a bech32 address, optionally re-encoding
+// it with another human readable prefix. Hex input is encoded with the prefix.
+//  { "type": "CosmosBech32", "params": {"prefix": "iaa" }}
+//
+// CosmosDec
+//
+// The CosmosDec adapter formats a number as a Cosmos SDK sdk.Dec string, with
+// 18 decimal places.
+//  { "type": "CosmosDec" }
+//
+// CosmosCoin
+//
+// The CosmosCoin adapter converts an amount to a Cosmos SDK coin string,
+// shifting it by the given exponent into the given denomination.
+//  { "type": "CosmosCoin", "params": {"denom": "uirita", "exponent": 6 }}
+//
 // Random
 //
 // Random adapter generates proofs of randomness verifiable against a public key
@@ -18,13 +18,13 @@ type DeviationCheckerFactory struct {
 	mock.Mock
 }
 
-// New provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
-func (_m *DeviationCheckerFactory) New(_a0 models.Initiator, _a1 *assets.Link, _a2 fluxmonitor.RunManager, _a3 *orm.ORM, _a4 models.Duration) (fluxmonitor.DeviationChecker, error) {
-	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+// New provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4, _a5
+func (_m *DeviationCheckerFactory) New(_a0 models.Initiator, _a1 string, _a2 *assets.Link, _a3 fluxmonitor.RunManager, _a4 *orm.ORM, _a5 models.Duration) (fluxmonitor.DeviationChecker, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5)
 
 	var r0 fluxmonitor.DeviationChecker
-	if rf, ok := ret.Get(0).(func(models.Initiator, *assets.Link, fluxmonitor.RunManager, *orm.ORM, models.Duration) fluxmonitor.DeviationChecker); ok {
-		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	if rf, ok := ret.Get(0).(func(models.Initiator, string, *assets.Link, fluxmonitor.RunManager, *orm.ORM, models.Duration) fluxmonitor.DeviationChecker); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(fluxmonitor.DeviationChecker)
@@ -32,8 +32,8 @@ func (_m *DeviationCheckerFactory) New(_a0 models.Initiator, _a1 *assets.Link, _
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(models.Initiator, *assets.Link, fluxmonitor.RunManager, *orm.ORM, models.Duration) error); ok {
-		r1 = rf(_a0, _a1, _a2, _a3, _a4)
+	if rf, ok := ret.Get(1).(func(models.Initiator, string, *assets.Link, fluxmonitor.RunManager, *orm.ORM, models.Duration) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -207,6 +207,7 @@ func (fm *concreteFluxMonitor) AddJob(job models.JobSpec) error {
 		timeout := fm.store.Config.DefaultHTTPTimeout()
 		checker, err := fm.checkerFactory.New(
 			initr,
+			job.Environment,
 			job.MinPayment,
 			fm.runManager,
 			fm.store.ORM,
@@ -239,7 +240,7 @@ func (fm *concreteFluxMonitor) RemoveJob(id *models.ID) {
 // DeviationCheckerFactory holds the New method needed to create a new instance
 // of a DeviationChecker.
 type DeviationCheckerFactory interface {
-	New(models.Initiator, *assets.Link, RunManager, *orm.ORM, models.Duration) (DeviationChecker, error)
+	New(models.Initiator, string, *assets.Link, RunManager, *orm.ORM, models.Duration) (DeviationChecker, error)
 }
 
 type pollingDeviationCheckerFactory struct {
@@ -249,6 +250,7 @@ type pollingDeviationCheckerFactory struct {
 
 func (f pollingDeviationCheckerFactory) New(
 	initr models.Initiator,
+	environment string,
 	minJobPayment *assets.Link,
 	runManager RunManager,
 	orm *orm.ORM,
@@ -261,7 +263,7 @@ func (f pollingDeviationCheckerFactory) New(
 		return nil, fmt.Errorf("pollTimer.period must be equal or greater than %s", minimumPollingInterval)
 	}
 
-	urls, err := ExtractFeedURLs(initr.Feeds, orm)
+	urls, err := ExtractFeedURLs(initr.Feeds, environment, orm)
 	if err != nil {
 		return nil, err
 	}
@@ -291,8 +293,9 @@ func (f pollingDeviationCheckerFactory) New(
 	)
 }
 
-// ExtractFeedURLs extracts a list of url.URLs from the feeds parameter of the initiator params
-func ExtractFeedURLs(feeds models.Feeds, orm *orm.ORM) ([]*url.URL, error) {
+// ExtractFeedURLs extracts a list of url.URLs from the feeds parameter of the initiator params,
+// resolving named bridge feeds to their URL for the given job environment
+func ExtractFeedURLs(feeds models.Feeds, environment string, orm *orm.ORM) ([]*url.URL, error) {
 	var feedsData []interface{}
 	var urls []*url.URL
 
@@ -313,7 +316,7 @@ func ExtractFeedURLs(feeds models.Feeds, orm *orm.ORM) ([]*url.URL, error) {
 			if !ok {
 				return nil, errors.New("failed to convert bright type into string")
 			}
-			bridgeURL, err = GetBridgeURLFromName(bridgeName, orm) // XXX: currently an n query
+			bridgeURL, err = GetBridgeURLFromName(bridgeName, environment, orm) // XXX: currently an n query
 		default:
 			err = errors.New("unable to extract feed URLs from json")
 		}
@@ -328,13 +331,18 @@ func ExtractFeedURLs(feeds models.Feeds, orm *orm.ORM) ([]*url.URL, error) {
 }
 
 // GetBridgeURLFromName looks up a bridge in the DB by name, then extracts the url
-func GetBridgeURLFromName(name string, orm *orm.ORM) (*url.URL, error) {
+// for the given environment
+func GetBridgeURLFromName(name string, environment string, orm *orm.ORM) (*url.URL, error) {
 	task := models.TaskType(name)
 	bridge, err := orm.FindBridge(task)
 	if err != nil {
 		return nil, err
 	}
-	bridgeURL := url.URL(bridge.URL)
+	webURL, err := bridge.URLFor(environment)
+	if err != nil {
+		return nil, err
+	}
+	bridgeURL := url.URL(webURL)
 	return &bridgeURL, nil
 }
 
@@ -96,7 +96,7 @@ func TestConcreteFluxMonitor_AddJobRemoveJob(t *testing.T) {
 		})
 
 		checkerFactory := new(mocks.DeviationCheckerFactory)
-		checkerFactory.On("New", job.Initiators[0], mock.Anything, runManager, store.ORM, store.Config.DefaultHTTPTimeout()).Return(dc, nil)
+		checkerFactory.On("New", job.Initiators[0], job.Environment, mock.Anything, runManager, store.ORM, store.Config.DefaultHTTPTimeout()).Return(dc, nil)
 		lb := eth.NewLogBroadcaster(store.TxManager, store.ORM, store.Config.BlockBackfillDepth())
 		require.NoError(t, lb.Start())
 		fm := fluxmonitor.New(store, runManager, lb)
@@ -1006,13 +1006,39 @@ func TestExtractFeedURLs(t *testing.T) {
 			for _, urlString := range test.expectation {
 				expectation = append(expectation, cltest.MustParseURL(urlString))
 			}
-			val, err := fluxmonitor.ExtractFeedURLs(initiatorParams.Feeds, store.ORM)
+			val, err := fluxmonitor.ExtractFeedURLs(initiatorParams.Feeds, "", store.ORM)
 			require.NoError(t, err)
 			assert.Equal(t, val, expectation)
 		})
 	}
 }
 
+func TestExtractFeedURLs_Environment(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	bridge := &models.BridgeType{
+		Name: models.MustNewTaskType("testbridge"),
+		URL:  cltest.WebURL(t, "https://testing.com/bridges"),
+		Environments: models.BridgeEnvironments{
+			"staging": cltest.WebURL(t, "https://staging.testing.com/bridges"),
+		},
+	}
+	require.NoError(t, store.CreateBridgeType(bridge))
+
+	feeds := cltest.JSONFromString(t, `["https://lambda.staging.devnet.tools/bnc/call", {"bridge": "testbridge"}]`)
+
+	val, err := fluxmonitor.ExtractFeedURLs(feeds, "staging", store.ORM)
+	require.NoError(t, err)
+	assert.Equal(t, []*url.URL{
+		cltest.MustParseURL("https://lambda.staging.devnet.tools/bnc/call"),
+		cltest.MustParseURL("https://staging.testing.com/bridges"),
+	}, val)
+
+	_, err = fluxmonitor.ExtractFeedURLs(feeds, "sandbox", store.ORM)
+	assert.EqualError(t, err, "bridge testbridge has no URL for environment sandbox")
+}
+
 func TestPollingDeviationChecker_SufficientPayment(t *testing.T) {
 	t.Parallel()
 
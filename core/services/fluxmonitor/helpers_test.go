@@ -131,7 +131,7 @@ func (fm *concreteFluxMonitor) CreateJob(t *testing.T, jobSpecId *models.ID, pol
 	jobSpec, err := fm.store.ORM.FindJob(jobSpecId)
 	require.NoError(t, err, "could not find job spec with that ID")
 
-	checker, err := fm.checkerFactory.New(jobSpec.Initiators[0], nil, fm.runManager, fm.store.ORM, models.MustMakeDuration(100*time.Second))
+	checker, err := fm.checkerFactory.New(jobSpec.Initiators[0], jobSpec.Environment, nil, fm.runManager, fm.store.ORM, models.MustMakeDuration(100*time.Second))
 	require.NoError(t, err, "could not create deviation checker")
 
 	payment := fm.store.Config.MinimumContractPayment()
@@ -31,13 +31,16 @@ func ValidateJob(j models.JobSpec, store *store.Store) error {
 	if len(j.Initiators) < 1 || len(j.Tasks) < 1 {
 		fe.Add("Must have at least one Initiator and one Task")
 	}
+	if j.Environment != "" && !bridgeEnvironmentNameRegexp.MatchString(j.Environment) {
+		fe.Add(fmt.Sprintf("Environment %v must be alphanumeric and may contain '_' or '-'", j.Environment))
+	}
 	for _, i := range j.Initiators {
 		if err := ValidateInitiator(i, j, store); err != nil {
 			fe.Merge(err)
 		}
 	}
 	for _, task := range j.Tasks {
-		if err := validateTask(task, store); err != nil {
+		if err := validateTask(task, j.Environment, store); err != nil {
 			fe.Merge(err)
 		}
 	}
@@ -50,7 +53,7 @@ func ValidateBridgeTypeNotExist(bt *models.BridgeTypeRequest, store *store.Store
 	bridge, err := store.ORM.FindBridge(bt.Name)
 	if err != nil && err != gorm.ErrRecordNotFound {
 		fe.Add(fmt.Sprintf("Error determining if bridge type %v already exists", bt.Name))
-	} else if (bridge != models.BridgeType{}) {
+	} else if err == nil {
 		fe.Add(fmt.Sprintf("Bridge Type %v already exists", bt.Name))
 	}
 	return fe.CoerceEmptyToNil()
@@ -70,6 +73,14 @@ func ValidateBridgeType(bt *models.BridgeTypeRequest, store *store.Store) error
 	if len(strings.TrimSpace(u)) == 0 {
 		fe.Add("URL must be present")
 	}
+	for environment, environmentURL := range bt.Environments {
+		if !bridgeEnvironmentNameRegexp.MatchString(environment) {
+			fe.Add(fmt.Sprintf("Environment %v must be alphanumeric and may contain '_' or '-'", environment))
+		}
+		if len(strings.TrimSpace(environmentURL.String())) == 0 {
+			fe.Add(fmt.Sprintf("URL for environment %v must be present", environment))
+		}
+	}
 	if bt.MinimumContractPayment != nil &&
 		bt.MinimumContractPayment.Cmp(assets.NewLink(0)) < 0 {
 		fe.Add("MinimumContractPayment must be positive")
@@ -83,6 +94,7 @@ func ValidateBridgeType(bt *models.BridgeTypeRequest, store *store.Store) error
 
 var (
 	externalInitiatorNameRegexp = regexp.MustCompile("^[a-zA-Z0-9-_]+$")
+	bridgeEnvironmentNameRegexp = regexp.MustCompile("^[a-zA-Z0-9-_]+$")
 )
 
 // ValidateExternalInitiator checks whether External Initiator parameters are
@@ -189,14 +201,14 @@ func validateFluxMonitor(i models.Initiator, j models.JobSpec, store *store.Stor
 		}
 	}
 
-	if err := validateFeeds(i.Feeds, store); err != nil {
+	if err := validateFeeds(i.Feeds, j.Environment, store); err != nil {
 		fe.Add(err.Error())
 	}
 
 	return fe.CoerceEmptyToNil()
 }
 
-func validateFeeds(feeds models.Feeds, store *store.Store) error {
+func validateFeeds(feeds models.Feeds, environment string, store *store.Store) error {
 	var feedsData []interface{}
 	if err := json.Unmarshal(feeds.Bytes(), &feedsData); err != nil {
 		return errors.New("invalid json for feeds parameter")
@@ -227,9 +239,15 @@ func validateFeeds(feeds models.Feeds, store *store.Store) error {
 			return errors.New("Unknown feed type")
 		}
 	}
-	if _, err := store.ORM.FindBridgesByNames(bridgeNames); err != nil {
+	bridges, err := store.ORM.FindBridgesByNames(bridgeNames)
+	if err != nil {
 		return err
 	}
+	for _, bridge := range bridges {
+		if _, err := bridge.URLFor(environment); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -313,11 +331,16 @@ func validateRandomnessLogInitiator(i models.Initiator, j models.JobSpec) error
 	return fe.CoerceEmptyToNil()
 }
 
-func validateTask(task models.TaskSpec, store *store.Store) error {
+func validateTask(task models.TaskSpec, environment string, store *store.Store) error {
 	adapter, err := adapters.For(task, store.Config, store.ORM)
 	if err != nil {
 		return err
 	}
+	if bridge, ok := adapter.BaseAdapter.(*adapters.Bridge); ok {
+		if _, err := bridge.URLFor(environment); err != nil {
+			return err
+		}
+	}
 	if !store.Config.EnableExperimentalAdapters() {
 		if _, ok := adapter.BaseAdapter.(*adapters.Sleep); ok {
 			return errors.New("Sleep Adapter is not implemented yet")
@@ -104,6 +104,39 @@ func TestValidateJob_RejectsSleepAdapterWhenExperimentalAdaptersAreDisabled(t *t
 	assert.Error(t, services.ValidateJob(sleepingJob, store))
 }
 
+func TestValidateJob_RejectsEnvironmentMissingFromBridge(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	_, bt := cltest.NewBridgeType(t, "pricefeed", "https://prod.example.com/api")
+	bt.Environments = models.BridgeEnvironments{
+		"staging": cltest.WebURL(t, "https://staging.example.com/api"),
+	}
+	require.NoError(t, store.CreateBridgeType(bt))
+
+	job := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{{Type: bt.Name}}
+
+	job.Environment = "staging"
+	assert.NoError(t, services.ValidateJob(job, store))
+
+	job.Environment = "sandbox"
+	assert.Error(t, services.ValidateJob(job, store))
+}
+
+func TestValidateJob_RejectsInvalidEnvironmentName(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithWebInitiator()
+
+	job.Environment = "staging-2"
+	assert.NoError(t, services.ValidateJob(job, store))
+
+	job.Environment = "staging 2"
+	assert.Error(t, services.ValidateJob(job, store))
+}
+
 func TestValidateBridgeType(t *testing.T) {
 	t.Parallel()
 
@@ -172,6 +205,39 @@ func TestValidateBridgeType(t *testing.T) {
 			},
 			models.NewJSONAPIErrorsWith("MinimumContractPayment must be positive"),
 		},
+		{
+			"valid environments",
+			models.BridgeTypeRequest{
+				Name: "adapterwithenvironments",
+				URL:  cltest.WebURL(t, "https://denergy.eth"),
+				Environments: models.BridgeEnvironments{
+					"staging": cltest.WebURL(t, "https://staging.denergy.eth"),
+				},
+			},
+			nil,
+		},
+		{
+			"invalid environment name",
+			models.BridgeTypeRequest{
+				Name: "adapterwithenvironments",
+				URL:  cltest.WebURL(t, "https://denergy.eth"),
+				Environments: models.BridgeEnvironments{
+					"staging/eu": cltest.WebURL(t, "https://staging.denergy.eth"),
+				},
+			},
+			models.NewJSONAPIErrorsWith("Environment staging/eu must be alphanumeric and may contain '_' or '-'"),
+		},
+		{
+			"invalid with blank environment url",
+			models.BridgeTypeRequest{
+				Name: "adapterwithenvironments",
+				URL:  cltest.WebURL(t, "https://denergy.eth"),
+				Environments: models.BridgeEnvironments{
+					"staging": cltest.WebURL(t, ""),
+				},
+			},
+			models.NewJSONAPIErrorsWith("URL for environment staging must be present"),
+		},
 		{
 			"existing core adapter",
 			models.BridgeTypeRequest{
@@ -500,3 +566,30 @@ func TestValidateInitiator_FeedsErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateInitiator_FeedsEnvironment(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	bridge := &models.BridgeType{
+		Name: models.MustNewTaskType("testbridge"),
+		URL:  cltest.WebURL(t, "https://testing.com/bridges"),
+		Environments: models.BridgeEnvironments{
+			"staging": cltest.WebURL(t, "https://staging.testing.com/bridges"),
+		},
+	}
+	require.NoError(t, store.CreateBridgeType(bridge))
+
+	job := cltest.NewJob()
+	var initr models.Initiator
+	require.NoError(t, json.Unmarshal([]byte(validInitiator), &initr))
+	initr.Feeds = cltest.JSONFromString(t, `["https://lambda.staging.devnet.tools/bnc/call", {"bridge": "testbridge"}]`)
+
+	job.Environment = "staging"
+	assert.NoError(t, services.ValidateInitiator(initr, job, store))
+
+	job.Environment = "sandbox"
+	assert.Error(t, services.ValidateInitiator(initr, job, store))
+}
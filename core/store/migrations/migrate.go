@@ -66,6 +66,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1598521075"
 	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1598972982"
 	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1599062163"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1599691818"
 
 	"github.com/jinzhu/gorm"
 	"github.com/pkg/errors"
@@ -328,6 +329,11 @@ func init() {
 			ID:      "1599062163",
 			Migrate: migration1599062163.Migrate,
 		},
+		{
+			ID:       "1599691818",
+			Migrate:  migration1599691818.Migrate,
+			Rollback: migration1599691818.Rollback,
+		},
 	}
 }
 
@@ -0,0 +1,26 @@
+package migration1599691818
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+const up = `
+ALTER TABLE bridge_types ADD COLUMN IF NOT EXISTS environments jsonb;
+ALTER TABLE job_specs ADD COLUMN IF NOT EXISTS environment text NOT NULL DEFAULT '';
+`
+
+const down = `
+ALTER TABLE bridge_types DROP COLUMN environments;
+ALTER TABLE job_specs DROP COLUMN environment;
+`
+
+// Migrate adds per-environment bridge URLs and the environment a job is
+// bound to.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(up).Error
+}
+
+// Rollback removes per-environment bridge URLs and job environments.
+func Rollback(tx *gorm.DB) error {
+	return tx.Exec(down).Error
+}
@@ -2,6 +2,8 @@ package models
 
 import (
 	"crypto/subtle"
+	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -11,10 +13,11 @@ import (
 
 // BridgeTypeRequest is the incoming record used to create a BridgeType
 type BridgeTypeRequest struct {
-	Name                   TaskType     `json:"name"`
-	URL                    WebURL       `json:"url"`
-	Confirmations          uint32       `json:"confirmations"`
-	MinimumContractPayment *assets.Link `json:"minimumContractPayment"`
+	Name                   TaskType           `json:"name"`
+	URL                    WebURL             `json:"url"`
+	Environments           BridgeEnvironments `json:"environments,omitempty"`
+	Confirmations          uint32             `json:"confirmations"`
+	MinimumContractPayment *assets.Link       `json:"minimumContractPayment"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -36,12 +39,13 @@ func (bt *BridgeTypeRequest) SetID(value string) error {
 
 // BridgeTypeAuthentication is the record returned in response to a request to create a BridgeType
 type BridgeTypeAuthentication struct {
-	Name                   TaskType     `json:"name"`
-	URL                    WebURL       `json:"url"`
-	Confirmations          uint32       `json:"confirmations"`
-	IncomingToken          string       `json:"incomingToken"`
-	OutgoingToken          string       `json:"outgoingToken"`
-	MinimumContractPayment *assets.Link `json:"minimumContractPayment"`
+	Name                   TaskType           `json:"name"`
+	URL                    WebURL             `json:"url"`
+	Environments           BridgeEnvironments `json:"environments,omitempty"`
+	Confirmations          uint32             `json:"confirmations"`
+	IncomingToken          string             `json:"incomingToken"`
+	OutgoingToken          string             `json:"outgoingToken"`
+	MinimumContractPayment *assets.Link       `json:"minimumContractPayment"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -64,15 +68,16 @@ func (bt *BridgeTypeAuthentication) SetID(value string) error {
 // BridgeType is used for external adapters and has fields for
 // the name of the adapter and its URL.
 type BridgeType struct {
-	Name                   TaskType     `json:"name" gorm:"primary_key"`
-	URL                    WebURL       `json:"url"`
-	Confirmations          uint32       `json:"confirmations"`
-	IncomingTokenHash      string       `json:"-"`
-	Salt                   string       `json:"-"`
-	OutgoingToken          string       `json:"outgoingToken"`
-	MinimumContractPayment *assets.Link `json:"minimumContractPayment" gorm:"type:varchar(255)"`
-	CreatedAt              time.Time    `json:"-"`
-	UpdatedAt              time.Time    `json:"-"`
+	Name                   TaskType           `json:"name" gorm:"primary_key"`
+	URL                    WebURL             `json:"url"`
+	Environments           BridgeEnvironments `json:"environments,omitempty" gorm:"type:jsonb"`
+	Confirmations          uint32             `json:"confirmations"`
+	IncomingTokenHash      string             `json:"-"`
+	Salt                   string             `json:"-"`
+	OutgoingToken          string             `json:"outgoingToken"`
+	MinimumContractPayment *assets.Link       `json:"minimumContractPayment" gorm:"type:varchar(255)"`
+	CreatedAt              time.Time          `json:"-"`
+	UpdatedAt              time.Time          `json:"-"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -92,6 +97,46 @@ func (bt *BridgeType) SetID(value string) error {
 	return err
 }
 
+// URLFor returns the URL the bridge should be called on for jobs bound to the
+// given environment. Jobs without an environment use the bridge's default URL.
+func (bt BridgeType) URLFor(environment string) (WebURL, error) {
+	if environment == "" {
+		return bt.URL, nil
+	}
+	u, ok := bt.Environments[environment]
+	if !ok {
+		return WebURL{}, fmt.Errorf("bridge %v has no URL for environment %v", bt.Name, environment)
+	}
+	return u, nil
+}
+
+// BridgeEnvironments maps an environment name, such as "staging", to the URL
+// of the upstream endpoint a bridge uses for jobs bound to that environment.
+type BridgeEnvironments map[string]WebURL
+
+// Value returns this instance serialized for database storage.
+func (be BridgeEnvironments) Value() (driver.Value, error) {
+	if len(be) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(be)
+}
+
+// Scan reads the database value and returns an instance.
+func (be *BridgeEnvironments) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*be = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, be)
+	case string:
+		return json.Unmarshal([]byte(v), be)
+	default:
+		return fmt.Errorf("unable to convert %v of %T to BridgeEnvironments", value, value)
+	}
+}
+
 // NewBridgeType returns a bridge bridge type authentication (with plaintext
 // password) and a bridge type (with hashed password, for persisting)
 func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
@@ -108,6 +153,7 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 	return &BridgeTypeAuthentication{
 			Name:                   btr.Name,
 			URL:                    btr.URL,
+			Environments:           btr.Environments,
 			Confirmations:          btr.Confirmations,
 			IncomingToken:          incomingToken,
 			OutgoingToken:          outgoingToken,
@@ -115,6 +161,7 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 		}, &BridgeType{
 			Name:                   btr.Name,
 			URL:                    btr.URL,
+			Environments:           btr.Environments,
 			Confirmations:          btr.Confirmations,
 			IncomingTokenHash:      hash,
 			Salt:                   salt,
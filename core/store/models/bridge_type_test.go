@@ -36,3 +36,35 @@ func TestBridgeType_Authenticate(t *testing.T) {
 		})
 	}
 }
+
+func TestBridgeType_URLFor(t *testing.T) {
+	t.Parallel()
+
+	_, bt := cltest.NewBridgeType(t, "pricefeed", "https://prod.example.com/api")
+	bt.Environments = models.BridgeEnvironments{
+		"staging": cltest.WebURL(t, "https://staging.example.com/api"),
+	}
+
+	tests := []struct {
+		name        string
+		environment string
+		want        string
+		wantError   bool
+	}{
+		{"no environment", "", "https://prod.example.com/api", false},
+		{"known environment", "staging", "https://staging.example.com/api", false},
+		{"unknown environment", "sandbox", "", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			u, err := bt.URLFor(test.environment)
+			if test.wantError {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, test.want, u.String())
+			}
+		})
+	}
+}
@@ -19,11 +19,12 @@ import (
 
 // JobSpecRequest represents a schema for the incoming job spec request as used by the API.
 type JobSpecRequest struct {
-	Initiators []InitiatorRequest `json:"initiators"`
-	Tasks      []TaskSpecRequest  `json:"tasks"`
-	StartAt    null.Time          `json:"startAt"`
-	EndAt      null.Time          `json:"endAt"`
-	MinPayment *assets.Link       `json:"minPayment,omitempty"`
+	Initiators  []InitiatorRequest `json:"initiators"`
+	Tasks       []TaskSpecRequest  `json:"tasks"`
+	StartAt     null.Time          `json:"startAt"`
+	EndAt       null.Time          `json:"endAt"`
+	MinPayment  *assets.Link       `json:"minPayment,omitempty"`
+	Environment string             `json:"environment,omitempty"`
 }
 
 // InitiatorRequest represents a schema for incoming initiator requests as used by the API.
@@ -42,17 +43,21 @@ type TaskSpecRequest struct {
 // JobSpec is the definition for all the work to be carried out by the node
 // for a given contract. It contains the Initiators, Tasks (which are the
 // individual steps to be carried out), StartAt, EndAt, and CreatedAt fields.
+//
+// Environment optionally binds the job to a named bridge environment, so that
+// its bridge tasks call that environment's upstream endpoints.
 type JobSpec struct {
-	ID         *ID            `json:"id,omitempty" gorm:"primary_key;not null"`
-	CreatedAt  time.Time      `json:"createdAt" gorm:"index"`
-	Initiators []Initiator    `json:"initiators"`
-	MinPayment *assets.Link   `json:"minPayment,omitempty" gorm:"type:varchar(255)"`
-	Tasks      []TaskSpec     `json:"tasks"`
-	StartAt    null.Time      `json:"startAt" gorm:"index"`
-	EndAt      null.Time      `json:"endAt" gorm:"index"`
-	DeletedAt  null.Time      `json:"-" gorm:"index"`
-	UpdatedAt  time.Time      `json:"-"`
-	Errors     []JobSpecError `json:"-" gorm:"foreignkey:JobSpecID;association_autoupdate:false;association_autocreate:false"`
+	ID          *ID            `json:"id,omitempty" gorm:"primary_key;not null"`
+	CreatedAt   time.Time      `json:"createdAt" gorm:"index"`
+	Initiators  []Initiator    `json:"initiators"`
+	MinPayment  *assets.Link   `json:"minPayment,omitempty" gorm:"type:varchar(255)"`
+	Environment string         `json:"environment,omitempty"`
+	Tasks       []TaskSpec     `json:"tasks"`
+	StartAt     null.Time      `json:"startAt" gorm:"index"`
+	EndAt       null.Time      `json:"endAt" gorm:"index"`
+	DeletedAt   null.Time      `json:"-" gorm:"index"`
+	UpdatedAt   time.Time      `json:"-"`
+	Errors      []JobSpecError `json:"-" gorm:"foreignkey:JobSpecID;association_autoupdate:false;association_autocreate:false"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -99,6 +104,7 @@ func NewJobFromRequest(jsr JobSpecRequest) JobSpec {
 	jobSpec.EndAt = jsr.EndAt
 	jobSpec.StartAt = jsr.StartAt
 	jobSpec.MinPayment = jsr.MinPayment
+	jobSpec.Environment = jsr.Environment
 	return jobSpec
 }
 
@@ -6,6 +6,7 @@ import (
 	"database/sql"
 	"encoding"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -232,6 +233,20 @@ func (orm *ORM) FindJobRun(id *models.ID) (models.JobRun, error) {
 	return jr, err
 }
 
+// FindJobRunEnvironment returns the environment of the job spec the given
+// run belongs to.
+func (orm *ORM) FindJobRunEnvironment(runID *models.ID) (string, error) {
+	orm.MustEnsureAdvisoryLock()
+	var job models.JobSpec
+	err := orm.DB.
+		Unscoped().
+		Select("job_specs.environment").
+		Joins("JOIN job_runs ON job_runs.job_spec_id = job_specs.id").
+		Where("job_runs.id = ?", runID).
+		First(&job).Error
+	return job.Environment, err
+}
+
 // AllSyncEvents returns all sync events
 func (orm *ORM) AllSyncEvents(cb func(models.SyncEvent) error) error {
 	orm.MustEnsureAdvisoryLock()
@@ -618,6 +633,27 @@ func (orm *ORM) AnyJobWithType(taskTypeName string) (bool, error) {
 	return found, ignoreRecordNotFound(rval)
 }
 
+// AnyJobWithTypeInEnvironments checks if any job bound to one of the given
+// environments uses the bridge, either as a task or as a flux monitor feed.
+func (orm *ORM) AnyJobWithTypeInEnvironments(taskTypeName string, environments []string) (bool, error) {
+	orm.MustEnsureAdvisoryLock()
+	if len(environments) == 0 {
+		return false, nil
+	}
+	feed, err := json.Marshal([]map[string]string{{"bridge": taskTypeName}})
+	if err != nil {
+		return false, err
+	}
+	var count int
+	err = orm.DB.Model(&models.JobSpec{}).
+		Where("environment IN (?)", environments).
+		Where(`EXISTS (SELECT 1 FROM task_specs WHERE task_specs.job_spec_id = job_specs.id AND task_specs.deleted_at IS NULL AND task_specs.type = ?)
+			OR EXISTS (SELECT 1 FROM initiators WHERE initiators.job_spec_id = job_specs.id AND initiators.deleted_at IS NULL AND initiators.feeds::jsonb @> ?::jsonb)`,
+			taskTypeName, string(feed)).
+		Count(&count).Error
+	return count > 0, err
+}
+
 // IdempotentInsertEthTaskRunTx creates both eth_task_run_transaction and eth_tx in one hit
 // It can be called multiple times without error as long as the outcome would have resulted in the same database state
 func (orm *ORM) IdempotentInsertEthTaskRunTx(taskRunID models.ID, fromAddress common.Address, toAddress common.Address, encodedPayload []byte, gasLimit uint64) error {
@@ -1092,6 +1128,7 @@ func (orm *ORM) CreateBridgeType(bt *models.BridgeType) error {
 func (orm *ORM) UpdateBridgeType(bt *models.BridgeType, btr *models.BridgeTypeRequest) error {
 	orm.MustEnsureAdvisoryLock()
 	bt.URL = btr.URL
+	bt.Environments = btr.Environments
 	bt.Confirmations = btr.Confirmations
 	bt.MinimumContractPayment = btr.MinimumContractPayment
 	return orm.DB.Save(bt).Error
@@ -505,6 +505,41 @@ func TestORM_AnyJobWithType(t *testing.T) {
 
 }
 
+func TestORM_AnyJobWithTypeInEnvironments(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	js := cltest.NewJobWithWebInitiator()
+	js.Environment = "staging"
+	js.Tasks = []models.TaskSpec{{Type: models.MustNewTaskType("bridgetestname")}}
+	require.NoError(t, store.CreateJob(&js))
+
+	fm := cltest.NewJobWithFluxMonitorInitiator()
+	fm.Environment = "sandbox"
+	fm.Initiators[0].Feeds = cltest.JSONFromString(t, `["https://example.com", {"bridge": "feedbridge"}]`)
+	require.NoError(t, store.CreateJob(&fm))
+
+	found, err := store.AnyJobWithTypeInEnvironments("bridgetestname", []string{"staging"})
+	require.NoError(t, err)
+	assert.True(t, found)
+	found, err = store.AnyJobWithTypeInEnvironments("bridgetestname", []string{"sandbox"})
+	require.NoError(t, err)
+	assert.False(t, found)
+	found, err = store.AnyJobWithTypeInEnvironments("feedbridge", []string{"sandbox", "staging"})
+	require.NoError(t, err)
+	assert.True(t, found)
+	found, err = store.AnyJobWithTypeInEnvironments("bridgetestname", nil)
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	require.NoError(t, store.ArchiveJob(js.ID))
+	found, err = store.AnyJobWithTypeInEnvironments("bridgetestname", []string{"staging"})
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
 func TestORM_JobRunsCountFor(t *testing.T) {
 	t.Parallel()
 
@@ -1314,6 +1349,9 @@ func TestORM_UpdateBridgeType(t *testing.T) {
 
 	updateBridge := &models.BridgeTypeRequest{
 		URL: cltest.WebURL(t, "http:/updatedurl.com"),
+		Environments: models.BridgeEnvironments{
+			"staging": cltest.WebURL(t, "http:/staging.updatedurl.com"),
+		},
 	}
 
 	require.NoError(t, store.UpdateBridgeType(firstBridge, updateBridge))
@@ -1321,6 +1359,7 @@ func TestORM_UpdateBridgeType(t *testing.T) {
 	foundbridge, err := store.FindBridge("UniqueName")
 	require.NoError(t, err)
 	require.Equal(t, updateBridge.URL, foundbridge.URL)
+	require.Equal(t, updateBridge.Environments, foundbridge.Environments)
 }
 
 func isDirEmpty(t *testing.T, dir string) bool {
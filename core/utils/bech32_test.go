@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBech32Decode_Valid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input   string
+		wantHRP string
+		want    string
+	}{
+		{"A12UEL5L", "a", ""},
+		{"a12uel5l", "a", ""},
+		{"abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxw", "abcdef", "00443214c74254b635cf84653a56d7c675be77df"},
+		{"iaa1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnk53tng", "iaa", "000102030405060708090a0b0c0d0e0f10111213"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			hrp, data, err := Bech32Decode(test.input)
+			require.NoError(t, err)
+			assert.Equal(t, test.wantHRP, hrp)
+			assert.Equal(t, test.want, hex.EncodeToString(data))
+		})
+	}
+}
+
+func TestBech32Decode_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"no separator", "pzry9x0s0muk"},
+		{"empty human readable part", "1pzry9x0s0muk"},
+		{"invalid data character", "x1b4n0q5v"},
+		{"checksum too short", "li1dgmt3"},
+		{"checksum over uppercase", "A1G7SGD8"},
+		{"mixed case", "A12uEL5L"},
+		{"bad checksum", "iaa1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnk53tnq"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, _, err := Bech32Decode(test.input)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestBech32Encode(t *testing.T) {
+	t.Parallel()
+
+	data, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f10111213")
+	require.NoError(t, err)
+
+	encoded, err := Bech32Encode("iaa", data)
+	require.NoError(t, err)
+	assert.Equal(t, "iaa1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnk53tng", encoded)
+
+	encoded, err = Bech32Encode("cosmos", data)
+	require.NoError(t, err)
+	assert.Equal(t, "cosmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnrk363e", encoded)
+
+	_, err = Bech32Encode("", data)
+	assert.Error(t, err)
+}
@@ -117,6 +117,21 @@ func (btc *BridgeTypesController) Update(c *gin.Context) {
 		jsonAPIError(c, http.StatusBadRequest, err)
 		return
 	}
+	var removed []string
+	for environment := range bt.Environments {
+		if _, ok := btr.Environments[environment]; !ok {
+			removed = append(removed, environment)
+		}
+	}
+	jobFounds, err := btc.App.GetStore().AnyJobWithTypeInEnvironments(bt.Name.String(), removed)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("error searching for associated jobs for BTC Update: %+v", err))
+		return
+	}
+	if jobFounds {
+		jsonAPIError(c, http.StatusConflict, fmt.Errorf("can't remove environments %v because there are jobs bound to them", removed))
+		return
+	}
 	if err := btc.App.GetStore().UpdateBridgeType(&bt, btr); err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
@@ -152,6 +152,51 @@ func TestBridgeTypesController_Update_Success(t *testing.T) {
 	assert.Equal(t, cltest.WebURL(t, "http://yourbridge"), ubt.URL)
 }
 
+func TestBridgeTypesController_Update_EnvironmentInUse(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	bt := &models.BridgeType{
+		Name: models.MustNewTaskType("bridgeb"),
+		URL:  cltest.WebURL(t, "http://mybridge"),
+		Environments: models.BridgeEnvironments{
+			"staging": cltest.WebURL(t, "http://staging.mybridge"),
+			"sandbox": cltest.WebURL(t, "http://sandbox.mybridge"),
+		},
+	}
+	require.NoError(t, app.GetStore().CreateBridgeType(bt))
+
+	js := cltest.NewJobWithWebInitiator()
+	js.Environment = "staging"
+	js.Tasks = []models.TaskSpec{{Type: bt.Name}}
+	require.NoError(t, app.Store.CreateJob(&js))
+
+	ud := bytes.NewBuffer([]byte(`{"name": "bridgeb","url":"http://mybridge","environments":{"sandbox":"http://sandbox.mybridge"}}`))
+	resp, cleanup := client.Patch("/v2/bridge_types/bridgeb", ud)
+	defer cleanup()
+	assert.Equal(t, http.StatusConflict, resp.StatusCode, "Response should be 409")
+
+	ud = bytes.NewBuffer([]byte(`{"name": "bridgeb","url":"http://mybridge","environments":{"staging":"http://staging.mybridge"}}`))
+	resp, cleanup = client.Patch("/v2/bridge_types/bridgeb", ud)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	require.NoError(t, app.Store.ArchiveJob(js.ID))
+
+	ud = bytes.NewBuffer([]byte(`{"name": "bridgeb","url":"http://mybridge"}`))
+	resp, cleanup = client.Patch("/v2/bridge_types/bridgeb", ud)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	ubt, err := app.Store.FindBridge(bt.Name)
+	require.NoError(t, err)
+	assert.Empty(t, ubt.Environments)
+}
+
 func TestBridgeController_Show(t *testing.T) {
 	t.Parallel()
 